@@ -0,0 +1,172 @@
+function __toolbox_containers
+    podman ps --all --filter label=com.github.debarshiray.toolbox=true --format '{{.Names}}' 2>/dev/null
+    podman ps --all --filter label=com.redhat.component=fedora-toolbox --format '{{.Names}}' 2>/dev/null
+end
+
+function __toolbox_images
+    podman images --filter label=com.github.debarshiray.toolbox=true --format '{{.Repository}}:{{.Tag}}' 2>/dev/null
+    podman images --filter label=com.redhat.component=fedora-toolbox --format '{{.Repository}}:{{.Tag}}' 2>/dev/null
+end
+
+function __toolbox_releases
+    set -l tokens (commandline --tokenize --cut-at-cursor)
+    set -l index (contains --index -- --distro $tokens)
+
+    if test -n "$index"; and test (count $tokens) -gt $index
+        toolbox list --releases --distro $tokens[(math $index + 1)] 2>/dev/null
+    else
+        toolbox list --releases 2>/dev/null
+    end
+end
+
+set -l commands bench clone commit completion config create doctor enter export help import info init-container list login logs prune reset restart rm rmi run search stats status top upgrade version
+set -l container_commands bench enter logs restart run status top upgrade
+
+complete -c toolbox -f
+
+complete -c toolbox -n "not __fish_seen_subcommand_from $commands" -s y -l assumeyes -d 'Automatically answer yes for all questions'
+complete -c toolbox -n "not __fish_seen_subcommand_from $commands" -s h -l help -d 'Display help information'
+complete -c toolbox -n "not __fish_seen_subcommand_from $commands" -l non-interactive -d 'Run without asking questions'
+complete -c toolbox -n "not __fish_seen_subcommand_from $commands" -l progress -x -a 'auto plain' -d 'How to show the progress of pulling images'
+complete -c toolbox -n "not __fish_seen_subcommand_from $commands" -l root -d 'Use rootful Podman through sudo'
+complete -c toolbox -n "not __fish_seen_subcommand_from $commands" -s v -l verbose -d 'Show debugging messages'
+complete -c toolbox -n "not __fish_seen_subcommand_from $commands" -o vv -l very-verbose -d 'Show debugging messages from podman too'
+complete -c toolbox -n "not __fish_seen_subcommand_from $commands" -l version -d 'Show the version of Toolbox'
+
+complete -c toolbox -n "not __fish_seen_subcommand_from $commands" -a bench -d 'Measure how long common toolbox operations take'
+complete -c toolbox -n "not __fish_seen_subcommand_from $commands" -a clone -d 'Duplicate an existing toolbox container'
+complete -c toolbox -n "not __fish_seen_subcommand_from $commands" -a commit -d 'Save the changes in a toolbox container as a new toolbox image'
+complete -c toolbox -n "not __fish_seen_subcommand_from $commands" -a completion -d 'Print a shell completion script'
+complete -c toolbox -n "not __fish_seen_subcommand_from $commands" -a config -d 'Show and change the configuration of Toolbox'
+complete -c toolbox -n "not __fish_seen_subcommand_from $commands" -a create -d 'Create a new toolbox container'
+complete -c toolbox -n "not __fish_seen_subcommand_from $commands" -a doctor -d 'Check if the host is set up to run toolbox containers'
+complete -c toolbox -n "not __fish_seen_subcommand_from $commands" -a enter -d 'Enter a toolbox container for interactive use'
+complete -c toolbox -n "not __fish_seen_subcommand_from $commands" -a export -d 'Export a toolbox container to a file'
+complete -c toolbox -n "not __fish_seen_subcommand_from $commands" -a help -d 'Display help information about Toolbox'
+complete -c toolbox -n "not __fish_seen_subcommand_from $commands" -a import -d 'Import a toolbox container from a file'
+complete -c toolbox -n "not __fish_seen_subcommand_from $commands" -a info -d 'Display information about the host and Toolbox'
+complete -c toolbox -n "not __fish_seen_subcommand_from $commands" -a init-container -d 'Initialize a running container'
+complete -c toolbox -n "not __fish_seen_subcommand_from $commands" -a list -d 'List existing toolbox containers and images'
+complete -c toolbox -n "not __fish_seen_subcommand_from $commands" -a login -d 'Log in to a registry of toolbox images'
+complete -c toolbox -n "not __fish_seen_subcommand_from $commands" -a logs -d 'Show the logs of a toolbox container'
+complete -c toolbox -n "not __fish_seen_subcommand_from $commands" -a prune -d 'Remove unused toolbox containers and images'
+complete -c toolbox -n "not __fish_seen_subcommand_from $commands" -a reset -d 'Remove all local podman (and toolbox) state'
+complete -c toolbox -n "not __fish_seen_subcommand_from $commands" -a restart -d 'Restart a toolbox container'
+complete -c toolbox -n "not __fish_seen_subcommand_from $commands" -a rm -d 'Remove one or more toolbox containers'
+complete -c toolbox -n "not __fish_seen_subcommand_from $commands" -a rmi -d 'Remove one or more toolbox images'
+complete -c toolbox -n "not __fish_seen_subcommand_from $commands" -a run -d 'Run a command in an existing toolbox container'
+complete -c toolbox -n "not __fish_seen_subcommand_from $commands" -a search -d 'Search registries for toolbox images'
+complete -c toolbox -n "not __fish_seen_subcommand_from $commands" -a stats -d 'Show the resource usage of toolbox containers'
+complete -c toolbox -n "not __fish_seen_subcommand_from $commands" -a status -d 'Display the status of a toolbox container'
+complete -c toolbox -n "not __fish_seen_subcommand_from $commands" -a top -d 'Show the processes running in a toolbox container'
+complete -c toolbox -n "not __fish_seen_subcommand_from $commands" -a upgrade -d 'Recreate a toolbox container from an updated image'
+complete -c toolbox -n "not __fish_seen_subcommand_from $commands" -a version -d 'Show the version of Toolbox'
+
+complete -c toolbox -n "__fish_seen_subcommand_from bench create $container_commands" -s c -l container -x -a '(__toolbox_containers)' -d 'Name of the toolbox container'
+complete -c toolbox -n "__fish_seen_subcommand_from bench create $container_commands" -l distro -x -a 'centos fedora rhel' -d 'Distribution of the toolbox container'
+complete -c toolbox -n "__fish_seen_subcommand_from bench create $container_commands" -s r -l release -x -a '(__toolbox_releases)' -d 'Release of the toolbox container'
+complete -c toolbox -n "__fish_seen_subcommand_from run" -s a -l all -d 'Run the command in all toolbox containers'
+complete -c toolbox -n "__fish_seen_subcommand_from run" -l containers -x -a '(__toolbox_containers)' -d 'Run the command in these toolbox containers'
+complete -c toolbox -n "__fish_seen_subcommand_from run" -s f -l file -r -d 'Script to run instead of a command'
+complete -c toolbox -n "__fish_seen_subcommand_from run" -l format -x -a 'json' -d 'Print a summary of the result'
+complete -c toolbox -n "__fish_seen_subcommand_from run" -l interpreter -x -a '(__fish_complete_command)' -d 'Interpreter for the script'
+complete -c toolbox -n "__fish_seen_subcommand_from run" -l fallback-to-host -d 'Run the command on the host if it or the container is missing'
+complete -c toolbox -n "__fish_seen_subcommand_from run" -l no-start -d 'Fail if the container is not running'
+complete -c toolbox -n "__fish_seen_subcommand_from run" -s o -l output -r -d 'Write the output of the command to this file'
+complete -c toolbox -n "__fish_seen_subcommand_from run" -l parallel -d 'Run the command in the containers at the same time'
+complete -c toolbox -n "__fish_seen_subcommand_from run" -s e -l env -x -d 'Set an environment variable'
+complete -c toolbox -n "__fish_seen_subcommand_from run" -l preserve-env -x -a '(set --names --export)' -d 'Forward an environment variable from the host'
+complete -c toolbox -n "__fish_seen_subcommand_from run" -s q -l quiet -d 'Do not show notices and warnings'
+complete -c toolbox -n "__fish_seen_subcommand_from run" -l timeout -x -d 'Stop the command after this long'
+complete -c toolbox -n "__fish_seen_subcommand_from run" -s u -l user -x -a 'root' -d 'User to run the command as'
+complete -c toolbox -n "__fish_seen_subcommand_from run" -s w -l workdir -x -a '(__fish_complete_directories)' -d 'Directory to run the command in'
+
+complete -c toolbox -n "__fish_seen_subcommand_from bench" -l iterations -x -d 'Number of times to measure each operation'
+complete -c toolbox -n "__fish_seen_subcommand_from bench" -l throwaway -d 'Also measure creating a throwaway container'
+
+complete -c toolbox -n "__fish_seen_subcommand_from clone commit export rm stats" -a '(__toolbox_containers)'
+complete -c toolbox -n "__fish_seen_subcommand_from completion" -a 'bash fish zsh'
+complete -c toolbox -n "__fish_seen_subcommand_from config; and not __fish_seen_subcommand_from get list set" -a 'get list set'
+
+complete -c toolbox -n "__fish_seen_subcommand_from create" -l build-context -x -a '(__fish_complete_directories)' -d 'Build the image from this directory'
+complete -c toolbox -n "__fish_seen_subcommand_from create" -l candidate-registry -x -d 'Try this registry first'
+complete -c toolbox -n "__fish_seen_subcommand_from create" -l cap-add -x -d 'Add a Linux capability'
+complete -c toolbox -n "__fish_seen_subcommand_from create" -l confined -d 'Isolate the container more from the host'
+complete -c toolbox -n "__fish_seen_subcommand_from create" -l containerfile -r -F -d 'Build the image from this Containerfile'
+complete -c toolbox -n "__fish_seen_subcommand_from create" -l cpus -x -d 'Limit the CPUs'
+complete -c toolbox -n "__fish_seen_subcommand_from create" -l dry-run -d 'Print the podman command instead of running it'
+complete -c toolbox -n "__fish_seen_subcommand_from create" -l env -x -d 'Set an environment variable'
+complete -c toolbox -n "__fish_seen_subcommand_from create" -l env-file -r -F -d 'Read environment variables from a file'
+complete -c toolbox -n "__fish_seen_subcommand_from create" -l epel -d 'Enable the EPEL repository'
+complete -c toolbox -n "__fish_seen_subcommand_from create" -l flatpak -d 'Mount the host Flatpak installation with write access'
+complete -c toolbox -n "__fish_seen_subcommand_from create" -l gidmap -x -d 'GID map for the user namespace'
+complete -c toolbox -n "__fish_seen_subcommand_from create" -l gpu -d 'Pass the GPUs of the host through'
+complete -c toolbox -n "__fish_seen_subcommand_from create" -l home -x -a 'ro rw' -d 'How to share the home directory'
+complete -c toolbox -n "__fish_seen_subcommand_from create" -l home-directory -x -a '(__fish_complete_directories)' -d 'Use this directory as the home directory'
+complete -c toolbox -n "__fish_seen_subcommand_from create" -l hostname -x -d 'Host name of the container'
+complete -c toolbox -n "__fish_seen_subcommand_from create" -l if-not-exists -d 'Do nothing if the container exists'
+complete -c toolbox -n "__fish_seen_subcommand_from create" -s i -l image -x -a '(__toolbox_images)' -d 'Image to create the container from'
+complete -c toolbox -n "__fish_seen_subcommand_from create" -l label -x -d 'Add a label to the container'
+complete -c toolbox -n "__fish_seen_subcommand_from create" -l memory -x -d 'Limit the memory'
+complete -c toolbox -n "__fish_seen_subcommand_from create" -l network -x -a 'host isolated' -d 'Network mode'
+complete -c toolbox -n "__fish_seen_subcommand_from create" -l no-locale-sync -d 'Keep the time zone and locale of the image'
+complete -c toolbox -n "__fish_seen_subcommand_from create" -l no-mount -r -F -d 'Host mount to disable'
+complete -c toolbox -n "__fish_seen_subcommand_from create" -l offline -d 'Never pull the image'
+complete -c toolbox -n "__fish_seen_subcommand_from create" -l packages -x -d 'Packages to install'
+complete -c toolbox -n "__fish_seen_subcommand_from create" -l pids-limit -x -d 'Limit the number of processes'
+complete -c toolbox -n "__fish_seen_subcommand_from create" -l podman-socket -d 'Make the host Podman socket available'
+complete -c toolbox -n "__fish_seen_subcommand_from create" -l privileged -d 'Give the container all capabilities and devices'
+complete -c toolbox -n "__fish_seen_subcommand_from create" -l publish -x -d 'Publish ports of an isolated container'
+complete -c toolbox -n "__fish_seen_subcommand_from create" -l pull-policy -x -a 'always missing never' -d 'When to pull the image'
+complete -c toolbox -n "__fish_seen_subcommand_from create" -s q -l quiet -d 'Show a spinner instead of the progress of the pull'
+complete -c toolbox -n "__fish_seen_subcommand_from create" -l require-signed -d 'Refuse images whose signature is not verified'
+complete -c toolbox -n "__fish_seen_subcommand_from create" -l secret -x -d 'Podman secret to add'
+complete -c toolbox -n "__fish_seen_subcommand_from create" -l selinux-confined -d 'Keep the SELinux confinement'
+complete -c toolbox -n "__fish_seen_subcommand_from create" -l signature-policy -r -F -d 'Verify the image with this signature policy'
+complete -c toolbox -n "__fish_seen_subcommand_from create" -l uid -x -d 'UID of the user inside the container'
+complete -c toolbox -n "__fish_seen_subcommand_from create" -l uidmap -x -d 'UID map for the user namespace'
+complete -c toolbox -n "__fish_seen_subcommand_from create" -l user -x -d 'Name of the user inside the container'
+complete -c toolbox -n "__fish_seen_subcommand_from create" -l userns -x -a 'keep-id nomap' -d 'User namespace mode'
+complete -c toolbox -n "__fish_seen_subcommand_from create" -l volume -r -F -d 'Bind mount a path from the host'
+complete -c toolbox -n "__fish_seen_subcommand_from create" -l wait -d 'Wait until the container is initialized'
+
+complete -c toolbox -n "__fish_seen_subcommand_from create info list search status version" -l format -x -a json -d 'Output format'
+
+complete -c toolbox -n "__fish_seen_subcommand_from enter" -l attach -d 'Attach to a shell session that survives the terminal'
+complete -c toolbox -n "__fish_seen_subcommand_from enter" -l keep-env -d 'Keep the environment of the host'
+complete -c toolbox -n "__fish_seen_subcommand_from enter" -l last -d 'Enter the container that was entered last'
+complete -c toolbox -n "__fish_seen_subcommand_from enter" -l no-banner -d 'Do not show the banner with the details of the container'
+
+complete -c toolbox -n "__fish_seen_subcommand_from export import" -F
+
+complete -c toolbox -n "__fish_seen_subcommand_from help" -a "$commands"
+
+complete -c toolbox -n "__fish_seen_subcommand_from import" -s c -l container -x -d 'Name of the toolbox container'
+
+complete -c toolbox -n "__fish_seen_subcommand_from list" -s c -l containers -d 'List only containers'
+complete -c toolbox -n "__fish_seen_subcommand_from list" -l distro -x -a 'centos fedora rhel' -d 'Distribution of the releases'
+complete -c toolbox -n "__fish_seen_subcommand_from list" -s i -l images -d 'List only images'
+complete -c toolbox -n "__fish_seen_subcommand_from list" -l releases -d 'List the available releases'
+complete -c toolbox -n "__fish_seen_subcommand_from list" -s w -l watch -d 'List again when containers or images change'
+
+complete -c toolbox -n "__fish_seen_subcommand_from create login upgrade" -l authfile -r -F -d 'File with the credentials for the registry'
+complete -c toolbox -n "__fish_seen_subcommand_from login" -l password-stdin -d 'Read the password from the standard input'
+complete -c toolbox -n "__fish_seen_subcommand_from login" -s u -l username -x -d 'User name'
+
+complete -c toolbox -n "__fish_seen_subcommand_from logs" -s f -l follow -d 'Follow the log output'
+complete -c toolbox -n "__fish_seen_subcommand_from logs" -l since -x -d 'Show logs since this time'
+complete -c toolbox -n "__fish_seen_subcommand_from logs" -l tail -x -d 'Number of lines to show'
+
+complete -c toolbox -n "__fish_seen_subcommand_from prune" -l dry-run -d 'Only show what would be removed'
+complete -c toolbox -n "__fish_seen_subcommand_from prune" -l older-than -x -d 'Days that a container has to be unused for'
+
+complete -c toolbox -n "__fish_seen_subcommand_from rm rmi" -s a -l all -d 'Remove all of them'
+complete -c toolbox -n "__fish_seen_subcommand_from rm rmi" -s f -l force -d 'Remove them even if in use'
+complete -c toolbox -n "__fish_seen_subcommand_from reset rm" -l dry-run -d 'Print the commands instead of running them'
+complete -c toolbox -n "__fish_seen_subcommand_from rmi" -a '(__toolbox_images)'
+
+complete -c toolbox -n "__fish_seen_subcommand_from search" -l distro -x -a 'centos fedora rhel' -d 'Distribution of the images'
+
+complete -c toolbox -n "__fish_seen_subcommand_from stats" -l no-stream -d 'Print the usage once'
+
+complete -c toolbox -n "__fish_seen_subcommand_from status" -l mounts -d 'Show the mounts'
@@ -31,6 +31,23 @@ if [ -f /run/.containerenv ] \
    && [ -f /run/.toolboxenv ]; then
     PS1=$(printf "\[\033[35m\]⬢\[\033[0m\]%s" "[\u@\h \W]\\$ ")
 
+    # TOOLBOX_CONTAINER is set by 'toolbox enter' and 'toolbox run', and is
+    # shown in the prompt and the title of the terminal.
+    if [ "$TOOLBOX_CONTAINER" != "" ]; then
+        PS1=$(printf "\[\033[35m\]⬢ %s\[\033[0m\]%s" "$TOOLBOX_CONTAINER" "[\u@\h \W]\\$ ")
+
+        toolbox_set_title()
+        {
+            printf "\033]0;⬢ %s: %s\007" "$TOOLBOX_CONTAINER" "$(basename "$PWD")"
+        }
+
+        if [ "$ZSH_VERSION" != "" ]; then
+            eval 'precmd_functions+=(toolbox_set_title)'
+        else
+            PROMPT_COMMAND="toolbox_set_title${PROMPT_COMMAND:+;$PROMPT_COMMAND}"
+        fi
+    fi
+
     if ! [ -f "$toolbox_welcome_stub" ]; then
         echo ""
         echo "Welcome to the Toolbox; a container where you can install and run"
@@ -50,6 +67,22 @@ if [ -f /run/.containerenv ] \
     fi
 fi
 
+if [ -f /run/.containerenv ] \
+   && [ -f /run/.toolboxenv ] \
+   && [ -f /run/.toolbox-host-fallback ] \
+   && command -v flatpak-spawn >/dev/null 2>&1; then
+    command_not_found_handle()
+    {
+        echo "toolbox: $1: command not found in the container; running it on the host" >&2
+        flatpak-spawn --host "$@"
+    }
+
+    command_not_found_handler()
+    {
+        command_not_found_handle "$@"
+    }
+fi
+
 unset toolbox_config
 unset host_welcome_stub
 unset toolbox_welcome_stub
@@ -3,12 +3,11 @@
 load helpers
 
 @test "Output version number using full flag" {
-  skip "Not implemented"
   run_toolbox --version
+  is "$output" "toolbox version [0-9]\+\.[0-9]\+\.[0-9]\+" "The version should be printed"
 }
 
 @test "Output version number using command" {
-  skip "Not implemented"
   run_toolbox version
+  is "${lines[0]}" "Version: [0-9]\+\.[0-9]\+\.[0-9]\+" "The version should be printed first"
 }
-
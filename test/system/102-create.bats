@@ -6,10 +6,30 @@ load helpers
   run_toolbox -y create
 }
 
+@test "Create the default container again, which already exists (--if-not-exists)" {
+  run_toolbox -y create --if-not-exists
+  is "${lines[0]}" "Container .* already exists" "Toolbox reports the existing container"
+}
+
 @test "Create a container with a valid custom name ('not-running')" {
   run_toolbox -y create -c "not-running"
 }
 
+@test "Container 'not-running' isn't privileged, and records its capabilities" {
+  run_podman inspect --format '{{.HostConfig.Privileged}}' not-running
+  is "$output" "false" "The container isn't privileged"
+
+  run_podman inspect --format '{{index .Config.Labels "com.github.containers.toolbox.capabilities"}}' not-running
+  is "$output" "AUDIT_WRITE,CHOWN,DAC_OVERRIDE,DAC_READ_SEARCH,FOWNER,FSETID,IPC_LOCK,KILL,MKNOD,NET_ADMIN,NET_BIND_SERVICE,NET_RAW,SETFCAP,SETGID,SETPCAP,SETUID,SYS_ADMIN,SYS_CHROOT,SYS_NICE,SYS_PTRACE,SYS_RESOURCE$" "The capabilities label lists the capabilities that Toolbox needs"
+}
+
+@test "Print the podman command without creating a container (--dry-run)" {
+  run_toolbox create --dry-run -c "dry-run"
+  is "$output" ".*podman create .*--cap-drop all .*" "Toolbox prints the podman command"
+
+  run_podman 1 container exists "dry-run"
+}
+
 @test "Create a container with a custom image and name ('running';f29)" {
   run_toolbox -y create -c "running" -i fedora-toolbox:29
 }
@@ -18,3 +38,13 @@ load helpers
   run_toolbox 1 -y create -c "ßpeci@l.Nam€"
   is "${lines[0]}" "toolbox: invalid argument for '--container'" "Toolbox reports invalid argument for --container"
 }
+
+@test "Try to create a container with an invalid release" {
+  run_toolbox 1 -y create -r "foo"
+  is "${lines[0]}" "toolbox: invalid argument for '--release'" "Toolbox reports invalid argument for --release"
+}
+
+@test "Try to create a container from a missing image in non-interactive mode" {
+  run_toolbox 3 --non-interactive create -c "non-interactive" -i "registry.fedoraproject.org/fedora-toolbox:does-not-exist"
+  is "${lines[1]}" "toolbox: unable to ask for confirmation in non-interactive mode" "Toolbox refuses to prompt"
+}
@@ -4,7 +4,7 @@ load helpers
 
 @test "Run list with three containers and two images" {
   run_toolbox list
-  is "${#lines[@]}" "8" "Expected number of lines of the output is 8 (Img: 3 + Cont: 5 (duplication expected))"
+  is "${#lines[@]}" "7" "Expected number of lines of the output is 7 (Img: 3 + Cont: 4)"
 
   is "${lines[1]}" ".*registry.fedoraproject.org/.*" "The first of the two images"
   is "${lines[2]}" ".*registry.fedoraproject.org/.*" "The second of the two images"
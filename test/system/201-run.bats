@@ -21,3 +21,21 @@ load helpers
   run_toolbox run -c running echo "Hello World"
   is "$output" "Hello World" "Should say 'Hello World'"
 }
+
+@test "Pass on the exit code of a failing command in the 'running' container" {
+  run_toolbox 42 run -c running sh -c "exit 42"
+}
+
+@test "Pass on the standard error stream of a command in the 'running' container" {
+  run_toolbox run -c running sh -c "echo 'Hello World' >&2"
+  is "$output" "Hello World" "Should say 'Hello World'"
+}
+
+@test "Echo 'Hello World' inside of all the containers (--all)" {
+  run_toolbox run --all echo "Hello World"
+  is "$output" ".*==> not-running <==.Hello World.*" "Should say 'Hello World' in 'not-running'"
+  is "$output" ".*==> running <==.Hello World.*" "Should say 'Hello World' in 'running'"
+
+  # The container 'not-running' is expected to be stopped when it's removed
+  run_podman stop not-running
+}
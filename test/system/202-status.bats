@@ -0,0 +1,31 @@
+#!/usr/bin/env bats
+
+load helpers
+
+@test "Show the status of the 'running' container" {
+  run_toolbox status -c running
+  is "${lines[0]}" "Container: running" "The first line should have the name of the container"
+}
+
+@test "Show the status of the 'running' container as JSON" {
+  run_toolbox status --format json -c running
+  is "${lines[0]}" "{" "The output should be a JSON object"
+  is "$output" ".*\"name\": \"running\".*" "The name of the container should be listed"
+  is "$output" ".*\"state\": \"running\".*" "The state of the container should be listed"
+}
+
+@test "Show the status of the 'running' container with a template" {
+  run_toolbox status --format "{{.Name}} {{.State}}" -c running
+  is "$output" "running running" "The name and state of the container should be printed"
+}
+
+@test "Show the mounts of the 'running' container" {
+  run_toolbox status --mounts -c running
+  is "${lines[0]}" "SOURCE *DESTINATION *OPTIONS *REASON" "The first line should be the header"
+  is "$output" ".*/run/host/etc .*host file system.*" "The host's /etc should be listed"
+}
+
+@test "Try to show the status of a nonexistent container" {
+  run_toolbox 1 status -c nonexistentcontainer
+  is "${lines[0]}" "toolbox: container nonexistentcontainer not found" "Toolbox should fail with: container not found"
+}
@@ -0,0 +1,15 @@
+#!/usr/bin/env bats
+
+load helpers
+
+@test "Upgrade the 'not-running' container" {
+  run_toolbox -y upgrade -c not-running
+  is "$output" ".*\(already using the latest\|Upgraded container not-running\).*" "Toolbox upgrades the container, or says it's up to date"
+
+  run_podman container exists not-running
+}
+
+@test "Try to upgrade a nonexistent container" {
+  run_toolbox 1 upgrade -c nonexistentcontainer
+  is "${lines[0]}" "toolbox: container nonexistentcontainer not found" "Toolbox should fail with: container not found"
+}
@@ -0,0 +1,27 @@
+#!/usr/bin/env bats
+
+load helpers
+
+@test "Export the 'not-running' container" {
+  run_toolbox export not-running "$BATS_TMPDIR/not-running.tar"
+  is "$output" "Exported container not-running to $BATS_TMPDIR/not-running.tar" "Toolbox reports the exported container"
+}
+
+@test "Import the exported container as 'imported'" {
+  run_toolbox import "$BATS_TMPDIR/not-running.tar" -c imported
+  is "$output" ".*Imported container imported from $BATS_TMPDIR/not-running.tar" "Toolbox reports the imported container"
+
+  run_podman inspect --format '{{index .Config.Labels "com.github.containers.toolbox"}}' imported
+  is "$output" "true" "The imported container is a toolbox container"
+}
+
+@test "Remove the imported container, its image and the exported file" {
+  run_podman rm imported
+  run_podman rmi localhost/imported-import:latest
+  rm "$BATS_TMPDIR/not-running.tar"
+}
+
+@test "Try to export a nonexistent container" {
+  run_toolbox 1 export nonexistentcontainer "$BATS_TMPDIR/nonexistentcontainer.tar"
+  is "${lines[0]}" "toolbox: container nonexistentcontainer not found" "Toolbox should fail with: container not found"
+}
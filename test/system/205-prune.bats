@@ -0,0 +1,15 @@
+#!/usr/bin/env bats
+
+load helpers
+
+@test "List the unused containers without removing them (--dry-run)" {
+  run_toolbox prune --dry-run --older-than 0
+  is "$output" ".*Would remove container not-running.*" "The stopped container 'not-running' should be listed"
+
+  run_podman container exists not-running
+}
+
+@test "Try to prune with an invalid age" {
+  run_toolbox 1 prune --older-than foo
+  is "${lines[0]}" "toolbox: invalid argument for '--older-than'" "Toolbox reports invalid argument for --older-than"
+}
@@ -0,0 +1,33 @@
+#!/usr/bin/env bats
+
+load helpers
+
+@test "Create a container from a Containerfile ('built')" {
+  mkdir -p "$BATS_TMPDIR/built"
+  printf "FROM %s\nRUN touch /built\n" "$TOOLBOX_DEFAULT_IMAGE" >"$BATS_TMPDIR/built/Containerfile"
+
+  run_toolbox -y create -c built --containerfile "$BATS_TMPDIR/built/Containerfile"
+}
+
+@test "Clone the 'built' container without building its image again" {
+  run_podman inspect --format '{{.Id}}' localhost/built-toolbox:latest
+  image_id="$output"
+
+  run_toolbox clone built built-clone
+  is "$output" ".*Cloned container built to built-clone" "Toolbox reports the cloned container"
+
+  run_podman inspect --format '{{.Id}}' localhost/built-toolbox:latest
+  is "$output" "$image_id" "The image built from the Containerfile is left alone"
+
+  run_podman inspect --format '{{.ImageName}}' built-clone
+  is "$output" "localhost/built-clone-clone:latest" "The clone is created from the committed image"
+
+  run_podman inspect --format '{{index .Config.Labels "com.github.containers.toolbox.create-arguments"}}' built-clone
+  [[ "$output" != *--containerfile* ]] || die "The clone shouldn't record '--containerfile'"
+}
+
+@test "Remove the 'built' container, its clone and their images" {
+  run_podman rm built built-clone
+  run_podman rmi localhost/built-clone-clone:latest localhost/built-toolbox:latest
+  rm --recursive "$BATS_TMPDIR/built"
+}
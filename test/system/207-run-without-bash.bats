@@ -0,0 +1,23 @@
+#!/usr/bin/env bats
+
+load helpers
+
+@test "Create a container without Bash from a Containerfile ('no-bash')" {
+  mkdir -p "$BATS_TMPDIR/no-bash"
+  printf "FROM %s\nRUN dnf --assumeyes install dash && ln --force --symbolic dash /usr/bin/sh && rm /usr/bin/bash\n" \
+         "$TOOLBOX_DEFAULT_IMAGE" >"$BATS_TMPDIR/no-bash/Containerfile"
+
+  run_toolbox -y create -c no-bash --containerfile "$BATS_TMPDIR/no-bash/Containerfile"
+}
+
+@test "Echo 'Hello World' inside of the 'no-bash' container" {
+  run_toolbox run -c no-bash echo "Hello World"
+  is "$output" "Hello World" "Should say 'Hello World'"
+}
+
+@test "Remove the 'no-bash' container and its image" {
+  run_podman stop no-bash
+  run_podman rm no-bash
+  run_podman rmi localhost/no-bash-toolbox:latest
+  rm --recursive "$BATS_TMPDIR/no-bash"
+}
@@ -6,3 +6,8 @@ load helpers
   run_toolbox rmi --all
   is "$output" "" "The output should be empty"
 }
+
+@test "Try to remove a non-existent image" {
+  run_toolbox 1 rmi nonexistentimage
+  is "${lines[0]}" "toolbox: image nonexistentimage not found" "Should say that the image doesn't exist"
+}